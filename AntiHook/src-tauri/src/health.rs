@@ -13,6 +13,16 @@ pub struct HealthCheckResult {
     pub error: Option<String>,
 }
 
+/// 出站请求统一使用的 User-Agent：`antihook/<version> (<os>/<arch>)`。
+fn user_agent() -> String {
+    format!(
+        "antihook/{} ({}/{})",
+        env!("CARGO_PKG_VERSION"),
+        std::env::consts::OS,
+        std::env::consts::ARCH
+    )
+}
+
 async fn fetch_health(
     client: &reqwest::Client,
     request_url: String,
@@ -56,6 +66,7 @@ pub async fn check_health(base_url: String) -> Result<HealthCheckResult, String>
     let base = normalize_base_url(&base_url).map_err(|e| e.to_string())?;
 
     let client = reqwest::Client::builder()
+        .user_agent(user_agent())
         .timeout(std::time::Duration::from_secs(8))
         .build()
         .map_err(|e| e.to_string())?;
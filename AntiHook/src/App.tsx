@@ -8,6 +8,13 @@ type AppConfig = {
   kiro_server_url: string;
 };
 
+const REQUIRED_URL_MESSAGE = '该项为必填，请输入服务地址';
+
+type LoadConfigResult = {
+  config: AppConfig | null;
+  warnings: string[];
+};
+
 type HealthCheckResult = {
   request_url: string;
   ok: boolean;
@@ -58,9 +65,13 @@ export default function App() {
         const path = await invoke<string>('get_config_path');
         if (!cancelled) setConfigPath(path);
 
-        const cfg = await invoke<AppConfig | null>('load_config');
-        if (!cancelled && cfg?.kiro_server_url) {
-          setServerUrl(cfg.kiro_server_url);
+        const loaded = await invoke<LoadConfigResult>('load_config');
+        if (cancelled) return;
+        if (loaded.config?.kiro_server_url) {
+          setServerUrl(loaded.config.kiro_server_url);
+        }
+        if (loaded.warnings.length > 0) {
+          setMessage(loaded.warnings.join('\n'));
         }
       } catch (e) {
         if (!cancelled) setMessage(String(e));
@@ -74,6 +85,10 @@ export default function App() {
   async function onSave() {
     setMessage('');
     setHealth(null);
+    if (!serverUrl.trim()) {
+      setMessage(REQUIRED_URL_MESSAGE);
+      return;
+    }
     setIsSaving(true);
     try {
       const normalized = await invoke<string>('save_config', { kiroServerUrl: serverUrl });
@@ -89,6 +104,10 @@ export default function App() {
   async function onCheck() {
     setMessage('');
     setHealth(null);
+    if (!serverUrl.trim()) {
+      setMessage(REQUIRED_URL_MESSAGE);
+      return;
+    }
     setIsChecking(true);
     try {
       const result = await invoke<HealthCheckResult>('check_health', { baseUrl: serverUrl });
@@ -154,7 +173,7 @@ export default function App() {
             </div>
 
             {message ? (
-              <div className="mt-5 rounded-xl border border-white/10 bg-slate-950/40 px-4 py-3 text-sm text-slate-200 animate-fade-in-up">
+              <div className="mt-5 rounded-xl border border-white/10 bg-slate-950/40 px-4 py-3 text-sm text-slate-200 whitespace-pre-line animate-fade-in-up">
                 {message}
               </div>
             ) : null}